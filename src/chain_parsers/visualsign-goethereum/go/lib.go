@@ -1,16 +1,21 @@
 package main
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
+	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
 // ABIInfo contains ABI information for a contract address
@@ -18,15 +23,72 @@ type ABIInfo struct {
 	Address common.Address `json:"address"`
 	ABI     abi.ABI        `json:"-"`
 	ABIJson string         `json:"abi"`
+
+	// UserDocJSON and DevDocJSON are solc's NatSpec userdoc/devdoc output
+	// ({"methods": {"transfer(address,uint256)": {"notice": "..."}}}),
+	// keyed by canonical method signature. Both are optional; when absent,
+	// calls decoded against this ABI render without a NatSpec description.
+	UserDocJSON string `json:"userdoc,omitempty"`
+	DevDocJSON  string `json:"devdoc,omitempty"`
+
+	userDoc map[string]abiMethodUserDoc `json:"-"`
+	devDoc  map[string]abiMethodDevDoc  `json:"-"`
+
+	// Unverified marks an ABIInfo synthesized from a SelectorResolver lookup
+	// rather than a caller-supplied, locally-known ABI. decodeCalldata
+	// surfaces this so a collision-prone, unauthenticated 4byte match isn't
+	// rendered as if it were a trusted decode.
+	Unverified bool `json:"-"`
+}
+
+// abiMethodUserDoc holds a single method's NatSpec @notice.
+type abiMethodUserDoc struct {
+	Notice string `json:"notice"`
+}
+
+// abiMethodDevDoc holds a single method's NatSpec @param descriptions, keyed
+// by parameter name.
+type abiMethodDevDoc struct {
+	Params map[string]string `json:"params"`
 }
 
 // TransactionDecoder handles Ethereum transaction decoding with ABI support
 type TransactionDecoder struct {
-	abiMap map[common.Address]ABIInfo
+	abiMap           map[common.Address]ABIInfo
+	chainID          *big.Int
+	selectorResolver SelectorResolver
+}
+
+// SelectorResolver resolves a 4-byte method selector to its canonical
+// function signature (e.g. "transfer(address,uint256)"), for contracts whose
+// ABI isn't known locally.
+type SelectorResolver interface {
+	Resolve(selector [4]byte) (signature string, err error)
+}
+
+// WithSelectorResolver configures a fallback resolver used to decode calldata
+// for contracts with no locally-registered ABI. Leave unset to disable the
+// fallback entirely, e.g. for offline/embedded use.
+func WithSelectorResolver(resolver SelectorResolver) DecoderOption {
+	return func(d *TransactionDecoder) {
+		d.selectorResolver = resolver
+	}
+}
+
+// DecoderOption configures optional TransactionDecoder behavior.
+type DecoderOption func(*TransactionDecoder)
+
+// WithChainID binds the decoder to a specific chain. The chain ID is used to
+// recover the transaction sender with a signer that matches how the
+// transaction was actually signed.
+func WithChainID(chainID *big.Int) DecoderOption {
+	return func(d *TransactionDecoder) {
+		d.chainID = chainID
+	}
 }
 
 // NewTransactionDecoder creates a new decoder with the provided ABI mappings
-func NewTransactionDecoder(abiInfos []ABIInfo) (*TransactionDecoder, error) {
+func NewTransactionDecoder(abiInfos []ABIInfo, opts ...DecoderOption) (*TransactionDecoder, error) {
 	decoder := &TransactionDecoder{
 		abiMap: make(map[common.Address]ABIInfo),
 	}
@@ -38,9 +100,34 @@ func NewTransactionDecoder(abiInfos []ABIInfo) (*TransactionDecoder, error) {
 			return nil, fmt.Errorf("failed to parse ABI for address %s: %w", info.Address.Hex(), err)
 		}
 		info.ABI = parsedABI
+
+		if info.UserDocJSON != "" {
+			var userDoc struct {
+				Methods map[string]abiMethodUserDoc `json:"methods"`
+			}
+			if err := json.Unmarshal([]byte(info.UserDocJSON), &userDoc); err != nil {
+				return nil, fmt.Errorf("failed to parse userdoc for address %s: %w", info.Address.Hex(), err)
+			}
+			info.userDoc = userDoc.Methods
+		}
+
+		if info.DevDocJSON != "" {
+			var devDoc struct {
+				Methods map[string]abiMethodDevDoc `json:"methods"`
+			}
+			if err := json.Unmarshal([]byte(info.DevDocJSON), &devDoc); err != nil {
+				return nil, fmt.Errorf("failed to parse devdoc for address %s: %w", info.Address.Hex(), err)
+			}
+			info.devDoc = devDoc.Methods
+		}
+
 		decoder.abiMap[info.Address] = info
 	}
 
+	for _, opt := range opts {
+		opt(decoder)
+	}
+
 	return decoder, nil
 }
 
@@ -57,10 +144,11 @@ func (d *TransactionDecoder) DecodeRawTransaction(rawTxHex string) (*SignablePay
 		return nil, fmt.Errorf("failed to decode hex transaction: %w", err)
 	}
 
-	// Parse RLP-encoded transaction
+	// UnmarshalBinary understands legacy RLP as well as the EIP-2718 typed
+	// envelopes (access-list and dynamic-fee transactions), unlike rlp.DecodeBytes.
 	var tx types.Transaction
-	if err := rlp.DecodeBytes(txBytes, &tx); err != nil {
-		return nil, fmt.Errorf("failed to decode RLP transaction: %w", err)
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
 	}
 
 	// Convert to visual sign payload
@@ -95,6 +183,36 @@ func (d *TransactionDecoder) transactionToVisualSign(tx *types.Transaction) (*Si
 		})
 	}
 
+	// From address field - recovered from the signature, since the payload
+	// shown to a signer should prove who a transaction actually claims to be
+	// from rather than trusting an unauthenticated "from" parameter.
+	signer, chainIDVerified := d.signerFor(tx)
+	if fromAddr, err := types.Sender(signer, tx); err == nil {
+		fallbackText := fromAddr.Hex()
+		name := d.getAddressName(fromAddr)
+		if !chainIDVerified {
+			// Surface the unverified state in the structured AddressV2 too
+			// (not just FallbackText), so a UI rendering Name/Address
+			// directly doesn't present an unauthenticated recovery as trusted.
+			fallbackText = fmt.Sprintf("%s (signature unverified)", fromAddr.Hex())
+			if name != "" {
+				name = fmt.Sprintf("%s (unverified signature)", name)
+			} else {
+				name = "Unverified signature"
+			}
+		}
+		fields = append(fields, SignablePayloadField{
+			Type:         "address_v2",
+			Label:        "From",
+			FallbackText: fallbackText,
+			AddressV2: &SignablePayloadFieldAddressV2{
+				Address:    fromAddr.Hex(),
+				Name:       name,
+				AssetLabel: "ETH",
+			},
+		})
+	}
+
 	// Value field
 	if tx.Value().Cmp(big.NewInt(0)) > 0 {
 		ethValue := new(big.Float).Quo(new(big.Float).SetInt(tx.Value()), big.NewFloat(1e18))
@@ -119,8 +237,29 @@ func (d *TransactionDecoder) transactionToVisualSign(tx *types.Transaction) (*Si
 		},
 	})
 
-	// Gas price field
-	if tx.GasPrice() != nil {
+	// Gas price field(s) - dynamic-fee transactions bid a max fee and a
+	// priority fee instead of a single gas price.
+	if tx.Type() == types.DynamicFeeTxType {
+		gweiMaxFee := new(big.Float).Quo(new(big.Float).SetInt(tx.GasFeeCap()), big.NewFloat(1e9))
+		fields = append(fields, SignablePayloadField{
+			Type:         "text_v2",
+			Label:        "Max Fee Per Gas",
+			FallbackText: fmt.Sprintf("%s Gwei", gweiMaxFee.String()),
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: fmt.Sprintf("%s Gwei", gweiMaxFee.String()),
+			},
+		})
+
+		gweiMaxPriority := new(big.Float).Quo(new(big.Float).SetInt(tx.GasTipCap()), big.NewFloat(1e9))
+		fields = append(fields, SignablePayloadField{
+			Type:         "text_v2",
+			Label:        "Max Priority Fee Per Gas",
+			FallbackText: fmt.Sprintf("%s Gwei", gweiMaxPriority.String()),
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: fmt.Sprintf("%s Gwei", gweiMaxPriority.String()),
+			},
+		})
+	} else if tx.GasPrice() != nil {
 		gweiPrice := new(big.Float).Quo(new(big.Float).SetInt(tx.GasPrice()), big.NewFloat(1e9))
 		fields = append(fields, SignablePayloadField{
 			Type:         "text_v2",
@@ -132,18 +271,50 @@ func (d *TransactionDecoder) transactionToVisualSign(tx *types.Transaction) (*Si
 		})
 	}
 
+	// Nonce and chain ID - typed transactions (EIP-2718) make the chain
+	// they're bound to explicit, so surface both for the signer to verify.
+	fields = append(fields, SignablePayloadField{
+		Type:         "text_v2",
+		Label:        "Nonce",
+		FallbackText: fmt.Sprintf("%d", tx.Nonce()),
+		TextV2: &SignablePayloadFieldTextV2{
+			Text: fmt.Sprintf("%d", tx.Nonce()),
+		},
+	})
+
+	if chainID := tx.ChainId(); chainID != nil && chainID.Sign() > 0 {
+		fields = append(fields, SignablePayloadField{
+			Type:         "text_v2",
+			Label:        "Chain ID",
+			FallbackText: chainID.String(),
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: chainID.String(),
+			},
+		})
+	}
+
+	// Access list field - access-list and dynamic-fee transactions may
+	// pre-declare the storage slots they intend to touch.
+	if accessList := tx.AccessList(); len(accessList) > 0 {
+		fields = append(fields, d.accessListField(accessList))
+	}
+
 	// Decode transaction data if available
 	if len(tx.Data()) > 0 {
 		if tx.To() != nil {
 			if abiInfo, exists := d.abiMap[*tx.To()]; exists {
 				// Try to decode with ABI
-				decodedField, err := d.decodeCalldata(tx.Data(), abiInfo.ABI, *tx.To())
+				decodedField, err := d.decodeCalldata(tx.Data(), abiInfo, *tx.To())
 				if err == nil {
 					fields = append(fields, *decodedField)
 				} else {
 					// Fall back to raw data
 					fields = append(fields, d.createRawDataField(tx.Data()))
 				}
+			} else if decodedField, err := d.decodeWithResolver(tx.Data(), *tx.To()); err == nil {
+				// No ABI available locally, but the selector resolved to a
+				// known signature - decode against that instead of raw hex.
+				fields = append(fields, *decodedField)
 			} else {
 				// No ABI available, show raw data
 				fields = append(fields, d.createRawDataField(tx.Data()))
@@ -170,7 +341,7 @@ func (d *TransactionDecoder) transactionToVisualSign(tx *types.Transaction) (*Si
 }
 
 // decodeCalldata attempts to decode transaction calldata using the provided ABI
-func (d *TransactionDecoder) decodeCalldata(data []byte, contractABI abi.ABI, contractAddress common.Address) (*SignablePayloadField, error) {
+func (d *TransactionDecoder) decodeCalldata(data []byte, abiInfo ABIInfo, contractAddress common.Address) (*SignablePayloadField, error) {
 	if len(data) < 4 {
 		return nil, fmt.Errorf("calldata too short")
 	}
@@ -179,7 +350,7 @@ func (d *TransactionDecoder) decodeCalldata(data []byte, contractABI abi.ABI, co
 	methodID := data[:4]
 
 	// Find matching method in ABI
-	method, err := contractABI.MethodById(methodID)
+	method, err := abiInfo.ABI.MethodById(methodID)
 	if err != nil {
 		return nil, fmt.Errorf("method not found in ABI: %w", err)
 	}
@@ -192,19 +363,57 @@ func (d *TransactionDecoder) decodeCalldata(data []byte, contractABI abi.ABI, co
 
 	// Create condensed view
 	condensedFields := []*AnnotatedPayloadField{}
+	condensedMethodText := method.Name
+	if abiInfo.Unverified {
+		condensedMethodText = fmt.Sprintf("%s (unverified signature)", method.Name)
+	}
 	condensedFields = append(condensedFields, &AnnotatedPayloadField{
 		SignablePayloadField: SignablePayloadField{
 			Type:         "text_v2",
 			Label:        "Method",
-			FallbackText: method.Name,
+			FallbackText: condensedMethodText,
 			TextV2: &SignablePayloadFieldTextV2{
-				Text: method.Name,
+				Text: condensedMethodText,
 			},
 		},
 	})
 
 	// Create expanded view with all parameters
 	expandedFields := []*AnnotatedPayloadField{}
+
+	// Resolver-sourced decodes aren't backed by a verified ABI - the
+	// signature came from an unauthenticated, collision-prone 4byte lookup -
+	// so say so up front rather than rendering it identically to a trusted
+	// decode.
+	if abiInfo.Unverified {
+		warning := fmt.Sprintf("Method %q was resolved from an unauthenticated 4byte.directory selector lookup, not a verified contract ABI - it may not match what this contract actually does.", method.Name)
+		expandedFields = append(expandedFields, &AnnotatedPayloadField{
+			SignablePayloadField: SignablePayloadField{
+				Type:         "text_v2",
+				Label:        "Description",
+				FallbackText: warning,
+				TextV2: &SignablePayloadFieldTextV2{
+					Text: warning,
+				},
+			},
+		})
+	} else if userDoc, ok := abiInfo.userDoc[method.Sig]; ok && userDoc.Notice != "" {
+		// NatSpec @notice, templated against the decoded arguments, turns an
+		// opaque call like "approve(0xRouter, 2^256-1)" into a human
+		// sentence. When present it leads the expanded view.
+		description := d.templateNatSpec(userDoc.Notice, method, args)
+		expandedFields = append(expandedFields, &AnnotatedPayloadField{
+			SignablePayloadField: SignablePayloadField{
+				Type:         "text_v2",
+				Label:        "Description",
+				FallbackText: description,
+				TextV2: &SignablePayloadFieldTextV2{
+					Text: description,
+				},
+			},
+		})
+	}
+
 	expandedFields = append(expandedFields, &AnnotatedPayloadField{
 		SignablePayloadField: SignablePayloadField{
 			Type:         "text_v2",
@@ -227,21 +436,21 @@ func (d *TransactionDecoder) decodeCalldata(data []byte, contractABI abi.ABI, co
 		},
 	})
 
-	// Add parameters to expanded view
+	// Add parameters to expanded view. Tuples and arrays of tuples get their
+	// own nested preview_layout rather than a flat text line, and any
+	// NatSpec @param description rides alongside the value as a sub-field.
+	devDoc, hasDevDoc := abiInfo.devDoc[method.Sig]
 	for i, input := range method.Inputs {
-		if i < len(args) {
-			value := d.formatABIValue(args[i], input.Type)
-			expandedFields = append(expandedFields, &AnnotatedPayloadField{
-				SignablePayloadField: SignablePayloadField{
-					Type:         "text_v2",
-					Label:        input.Name,
-					FallbackText: value,
-					TextV2: &SignablePayloadFieldTextV2{
-						Text: value,
-					},
-				},
-			})
+		if i >= len(args) {
+			continue
 		}
+		field := d.annotatedValueField(input.Name, args[i], input.Type)
+		if hasDevDoc {
+			if paramDescription, ok := devDoc.Params[input.Name]; ok && paramDescription != "" {
+				field = d.withParamDescription(field, paramDescription)
+			}
+		}
+		expandedFields = append(expandedFields, field)
 	}
 
 	return &SignablePayloadField{
@@ -262,6 +471,63 @@ func (d *TransactionDecoder) decodeCalldata(data []byte, contractABI abi.ABI, co
 	}, nil
 }
 
+// templateNatSpec substitutes each `` `paramName` `` placeholder in a NatSpec
+// @notice string with the decoded argument's formatted value.
+func (d *TransactionDecoder) templateNatSpec(notice string, method abi.Method, args []interface{}) string {
+	result := notice
+	for i, input := range method.Inputs {
+		if i >= len(args) {
+			break
+		}
+		placeholder := "`" + input.Name + "`"
+		if strings.Contains(result, placeholder) {
+			result = strings.ReplaceAll(result, placeholder, d.formatABIValue(args[i], input.Type))
+		}
+	}
+	return result
+}
+
+// withParamDescription attaches a NatSpec @param description to a decoded
+// argument field as a sibling "Description" sub-field, wrapping flat value
+// fields in a preview_layout so the two can sit alongside each other.
+func (d *TransactionDecoder) withParamDescription(field *AnnotatedPayloadField, description string) *AnnotatedPayloadField {
+	descriptionField := &AnnotatedPayloadField{
+		SignablePayloadField: SignablePayloadField{
+			Type:         "text_v2",
+			Label:        "Description",
+			FallbackText: description,
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: description,
+			},
+		},
+	}
+
+	if field.PreviewLayout != nil {
+		field.PreviewLayout.Expanded.Fields = append(field.PreviewLayout.Expanded.Fields, descriptionField)
+		return field
+	}
+
+	valueField := &AnnotatedPayloadField{SignablePayloadField: field.SignablePayloadField}
+	return &AnnotatedPayloadField{
+		SignablePayloadField: SignablePayloadField{
+			Type:         "preview_layout",
+			Label:        field.Label,
+			FallbackText: field.FallbackText,
+			PreviewLayout: &SignablePayloadFieldPreviewLayout{
+				Title: SignablePayloadFieldTextV2{
+					Text: field.Label,
+				},
+				Condensed: SignablePayloadFieldListLayout{
+					Fields: []*AnnotatedPayloadField{valueField},
+				},
+				Expanded: SignablePayloadFieldListLayout{
+					Fields: []*AnnotatedPayloadField{valueField, descriptionField},
+				},
+			},
+		},
+	}
+}
+
 // formatABIValue formats an ABI value for display
 func (d *TransactionDecoder) formatABIValue(value interface{}, abiType abi.Type) string {
 	switch abiType.T {
@@ -285,13 +551,490 @@ func (d *TransactionDecoder) formatABIValue(value interface{}, abiType abi.Type)
 		if bytes, ok := value.([]byte); ok {
 			return fmt.Sprintf("0x%x", bytes)
 		}
+	case abi.TupleTy:
+		return d.formatTuple(value, abiType)
 	case abi.SliceTy, abi.ArrayTy:
-		// For arrays/slices, recursively format elements
-		return fmt.Sprintf("%v", value)
+		return d.formatSlice(value, abiType)
 	}
 	return fmt.Sprintf("%v", value)
 }
 
+// formatTuple formats an abi.TupleTy value as a bracketed "{name: value, ...}"
+// string, recursing into nested tuples/arrays via formatABIValue.
+func (d *TransactionDecoder) formatTuple(value interface{}, abiType abi.Type) string {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Struct {
+		return fmt.Sprintf("%v", value)
+	}
+
+	parts := make([]string, 0, len(abiType.TupleRawNames))
+	for i, name := range abiType.TupleRawNames {
+		if i >= rv.NumField() || i >= len(abiType.TupleElems) {
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", name, d.formatABIValue(rv.Field(i).Interface(), *abiType.TupleElems[i])))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// formatSlice formats an abi.SliceTy/ArrayTy value by recursively formatting
+// each element with its element type, joined with commas.
+func (d *TransactionDecoder) formatSlice(value interface{}, abiType abi.Type) string {
+	rv := reflect.ValueOf(value)
+	if (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) || abiType.Elem == nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	parts := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		parts = append(parts, d.formatABIValue(rv.Index(i).Interface(), *abiType.Elem))
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// annotatedValueField builds an expanded-view field for a single decoded
+// argument. Tuples and arrays of tuples get a nested preview_layout with one
+// sub-field per member/element; everything else stays a flat text_v2 field.
+func (d *TransactionDecoder) annotatedValueField(label string, value interface{}, abiType abi.Type) *AnnotatedPayloadField {
+	switch {
+	case abiType.T == abi.TupleTy:
+		return d.tupleField(label, value, abiType)
+	case (abiType.T == abi.SliceTy || abiType.T == abi.ArrayTy) && abiType.Elem != nil && abiType.Elem.T == abi.TupleTy:
+		return d.arrayOfTuplesField(label, value, abiType)
+	}
+
+	text := d.formatABIValue(value, abiType)
+	return &AnnotatedPayloadField{
+		SignablePayloadField: SignablePayloadField{
+			Type:         "text_v2",
+			Label:        label,
+			FallbackText: text,
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: text,
+			},
+		},
+	}
+}
+
+// tupleField renders a single abi.TupleTy value as a preview_layout with one
+// expanded sub-field per struct member.
+func (d *TransactionDecoder) tupleField(label string, value interface{}, abiType abi.Type) *AnnotatedPayloadField {
+	memberFields := []*AnnotatedPayloadField{}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Struct {
+		for i, name := range abiType.TupleRawNames {
+			if i >= rv.NumField() || i >= len(abiType.TupleElems) {
+				break
+			}
+			memberFields = append(memberFields, d.annotatedValueField(name, rv.Field(i).Interface(), *abiType.TupleElems[i]))
+		}
+	}
+
+	summary := d.formatABIValue(value, abiType)
+	return &AnnotatedPayloadField{
+		SignablePayloadField: SignablePayloadField{
+			Type:         "preview_layout",
+			Label:        label,
+			FallbackText: summary,
+			PreviewLayout: &SignablePayloadFieldPreviewLayout{
+				Title: SignablePayloadFieldTextV2{
+					Text: label,
+				},
+				Condensed: SignablePayloadFieldListLayout{
+					Fields: []*AnnotatedPayloadField{
+						{
+							SignablePayloadField: SignablePayloadField{
+								Type:         "text_v2",
+								Label:        label,
+								FallbackText: summary,
+								TextV2: &SignablePayloadFieldTextV2{
+									Text: summary,
+								},
+							},
+						},
+					},
+				},
+				Expanded: SignablePayloadFieldListLayout{
+					Fields: memberFields,
+				},
+			},
+		},
+	}
+}
+
+// arrayOfTuplesField renders an array/slice of tuples as a preview_layout
+// with one nested tuple preview_layout per element.
+func (d *TransactionDecoder) arrayOfTuplesField(label string, value interface{}, abiType abi.Type) *AnnotatedPayloadField {
+	elemFields := []*AnnotatedPayloadField{}
+	rv := reflect.ValueOf(value)
+	count := 0
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		count = rv.Len()
+		for i := 0; i < count; i++ {
+			elemFields = append(elemFields, d.tupleField(fmt.Sprintf("%s[%d]", label, i), rv.Index(i).Interface(), *abiType.Elem))
+		}
+	}
+
+	summaryText := fmt.Sprintf("%d items", count)
+	return &AnnotatedPayloadField{
+		SignablePayloadField: SignablePayloadField{
+			Type:         "preview_layout",
+			Label:        label,
+			FallbackText: summaryText,
+			PreviewLayout: &SignablePayloadFieldPreviewLayout{
+				Title: SignablePayloadFieldTextV2{
+					Text: label,
+				},
+				Condensed: SignablePayloadFieldListLayout{
+					Fields: []*AnnotatedPayloadField{
+						{
+							SignablePayloadField: SignablePayloadField{
+								Type:         "text_v2",
+								Label:        label,
+								FallbackText: summaryText,
+								TextV2: &SignablePayloadFieldTextV2{
+									Text: summaryText,
+								},
+							},
+						},
+					},
+				},
+				Expanded: SignablePayloadFieldListLayout{
+					Fields: elemFields,
+				},
+			},
+		},
+	}
+}
+
+// accessListField renders an EIP-2930 access list as a nested preview_layout:
+// a condensed address/storage-key count up top, with each address and its
+// storage-key slots broken out in the expanded view.
+func (d *TransactionDecoder) accessListField(accessList types.AccessList) SignablePayloadField {
+	storageKeyCount := 0
+	for _, entry := range accessList {
+		storageKeyCount += len(entry.StorageKeys)
+	}
+	summary := fmt.Sprintf("%d addresses / %d storage keys", len(accessList), storageKeyCount)
+
+	expandedFields := []*AnnotatedPayloadField{}
+	for _, entry := range accessList {
+		storageKeyFields := []*AnnotatedPayloadField{}
+		for _, key := range entry.StorageKeys {
+			storageKeyFields = append(storageKeyFields, &AnnotatedPayloadField{
+				SignablePayloadField: SignablePayloadField{
+					Type:         "text_v2",
+					Label:        "Storage Key",
+					FallbackText: key.Hex(),
+					TextV2: &SignablePayloadFieldTextV2{
+						Text: key.Hex(),
+					},
+				},
+			})
+		}
+
+		expandedFields = append(expandedFields, &AnnotatedPayloadField{
+			SignablePayloadField: SignablePayloadField{
+				Type:         "preview_layout",
+				Label:        "Address",
+				FallbackText: fmt.Sprintf("%s (%d storage keys)", entry.Address.Hex(), len(entry.StorageKeys)),
+				PreviewLayout: &SignablePayloadFieldPreviewLayout{
+					Title: SignablePayloadFieldTextV2{
+						Text: entry.Address.Hex(),
+					},
+					Condensed: SignablePayloadFieldListLayout{
+						Fields: []*AnnotatedPayloadField{
+							{
+								SignablePayloadField: SignablePayloadField{
+									Type:         "text_v2",
+									Label:        "Storage Keys",
+									FallbackText: fmt.Sprintf("%d keys", len(entry.StorageKeys)),
+									TextV2: &SignablePayloadFieldTextV2{
+										Text: fmt.Sprintf("%d keys", len(entry.StorageKeys)),
+									},
+								},
+							},
+						},
+					},
+					Expanded: SignablePayloadFieldListLayout{
+						Fields: storageKeyFields,
+					},
+				},
+			},
+		})
+	}
+
+	return SignablePayloadField{
+		Type:         "preview_layout",
+		Label:        "Access List",
+		FallbackText: summary,
+		PreviewLayout: &SignablePayloadFieldPreviewLayout{
+			Title: SignablePayloadFieldTextV2{
+				Text: "Access List",
+			},
+			Condensed: SignablePayloadFieldListLayout{
+				Fields: []*AnnotatedPayloadField{
+					{
+						SignablePayloadField: SignablePayloadField{
+							Type:         "text_v2",
+							Label:        "Access List",
+							FallbackText: summary,
+							TextV2: &SignablePayloadFieldTextV2{
+								Text: summary,
+							},
+						},
+					},
+				},
+			},
+			Expanded: SignablePayloadFieldListLayout{
+				Fields: expandedFields,
+			},
+		},
+	}
+}
+
+// defaultFourByteEndpoint is the default 4byte.directory-style lookup
+// endpoint used by HTTPSelectorResolver.
+const defaultFourByteEndpoint = "https://www.4byte.directory/api/v1/signatures/"
+
+// defaultSelectorResolverTimeout bounds how long a selector lookup can block
+// a synchronous decode call before giving up and falling back to raw data.
+const defaultSelectorResolverTimeout = 5 * time.Second
+
+// HTTPSelectorResolver is the default SelectorResolver, backed by an
+// HTTP endpoint compatible with the 4byte.directory signature database. It
+// caches resolved selectors in memory so repeat lookups don't hit the network.
+type HTTPSelectorResolver struct {
+	Endpoint   string
+	HTTPClient *http.Client
+
+	mu    sync.Mutex
+	cache map[[4]byte]string
+}
+
+// NewHTTPSelectorResolver creates an HTTPSelectorResolver pointed at the
+// given 4byte-style endpoint. Pass "" to use defaultFourByteEndpoint.
+func NewHTTPSelectorResolver(endpoint string) *HTTPSelectorResolver {
+	if endpoint == "" {
+		endpoint = defaultFourByteEndpoint
+	}
+	return &HTTPSelectorResolver{
+		Endpoint:   endpoint,
+		HTTPClient: &http.Client{Timeout: defaultSelectorResolverTimeout},
+		cache:      make(map[[4]byte]string),
+	}
+}
+
+// Resolve looks up a method selector, preferring the in-memory cache before
+// querying the configured endpoint.
+func (r *HTTPSelectorResolver) Resolve(selector [4]byte) (string, error) {
+	r.mu.Lock()
+	if signature, ok := r.cache[selector]; ok {
+		r.mu.Unlock()
+		return signature, nil
+	}
+	r.mu.Unlock()
+
+	requestURL := fmt.Sprintf("%s?hex_signature=0x%x", r.Endpoint, selector)
+	resp, err := r.HTTPClient.Get(requestURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to query selector resolver: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("selector resolver returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Results []struct {
+			ID            int    `json:"id"`
+			TextSignature string `json:"text_signature"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode selector resolver response: %w", err)
+	}
+	if len(body.Results) == 0 {
+		return "", fmt.Errorf("no signature found for selector 0x%x", selector)
+	}
+
+	// Selectors collide, and 4byte.directory returns every signature anyone
+	// has ever submitted for one - unauthenticated and in no canonical
+	// order. Pick the lowest id (the earliest submission) deterministically
+	// rather than trusting whatever the API happened to return first.
+	best := body.Results[0]
+	for _, result := range body.Results[1:] {
+		if result.ID < best.ID {
+			best = result
+		}
+	}
+	signature := best.TextSignature
+	r.mu.Lock()
+	r.cache[selector] = signature
+	r.mu.Unlock()
+
+	return signature, nil
+}
+
+// decodeWithResolver resolves calldata's method selector via the configured
+// SelectorResolver and, on success, decodes it through the same path as a
+// locally-known ABI.
+func (d *TransactionDecoder) decodeWithResolver(data []byte, contractAddress common.Address) (*SignablePayloadField, error) {
+	if d.selectorResolver == nil {
+		return nil, fmt.Errorf("no selector resolver configured")
+	}
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata too short")
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+
+	signature, err := d.selectorResolver.Resolve(selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve selector 0x%x: %w", selector, err)
+	}
+
+	method, err := methodFromSignature(signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse resolved signature %q: %w", signature, err)
+	}
+
+	resolvedABI := abi.ABI{Methods: map[string]abi.Method{method.Name: method}}
+	return d.decodeCalldata(data, ABIInfo{Address: contractAddress, ABI: resolvedABI, Unverified: true}, contractAddress)
+}
+
+// methodFromSignature parses a canonical signature like
+// "transfer(address,uint256)" into an abi.Method, building each argument's
+// abi.Type with abi.NewType.
+func methodFromSignature(signature string) (abi.Method, error) {
+	name, paramsPart, err := splitSignature(signature)
+	if err != nil {
+		return abi.Method{}, err
+	}
+
+	inputs := abi.Arguments{}
+	for i, part := range splitTopLevelArgs(paramsPart) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		arg, err := parseABIArgument(part, i)
+		if err != nil {
+			return abi.Method{}, err
+		}
+		inputs = append(inputs, arg)
+	}
+
+	return abi.NewMethod(name, name, abi.Function, "nonpayable", false, false, inputs, nil), nil
+}
+
+// splitSignature splits "name(type,type,...)" into its name and the
+// parenthesised parameter list.
+func splitSignature(signature string) (name string, params string, err error) {
+	open := strings.Index(signature, "(")
+	if open == -1 || !strings.HasSuffix(signature, ")") {
+		return "", "", fmt.Errorf("malformed signature %q", signature)
+	}
+	return signature[:open], signature[open+1 : len(signature)-1], nil
+}
+
+// splitTopLevelArgs splits a comma-separated parameter list, respecting
+// parenthesis nesting so tuple-typed arguments aren't split apart.
+func splitTopLevelArgs(params string) []string {
+	if params == "" {
+		return nil
+	}
+
+	var args []string
+	depth := 0
+	start := 0
+	for i, r := range params {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, params[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(args, params[start:])
+}
+
+// parseABIArgument parses a single solidity type string - including tuple
+// types like "(address,uint256)[]" - into an abi.Argument.
+func parseABIArgument(typeStr string, index int) (abi.Argument, error) {
+	marshaling, err := parseArgumentMarshaling(typeStr, fmt.Sprintf("arg%d", index))
+	if err != nil {
+		return abi.Argument{}, err
+	}
+
+	abiType, err := abi.NewType(marshaling.Type, "", marshaling.Components)
+	if err != nil {
+		return abi.Argument{}, fmt.Errorf("failed to parse argument type %q: %w", typeStr, err)
+	}
+
+	return abi.Argument{Name: marshaling.Name, Type: abiType}, nil
+}
+
+// parseArgumentMarshaling recursively builds the abi.ArgumentMarshaling for a
+// type string, descending into tuple components so nested tuples resolve too.
+func parseArgumentMarshaling(typeStr, name string) (abi.ArgumentMarshaling, error) {
+	typeStr = strings.TrimSpace(typeStr)
+	if !strings.HasPrefix(typeStr, "(") {
+		return abi.ArgumentMarshaling{Name: name, Type: typeStr}, nil
+	}
+
+	closeIdx := matchingParen(typeStr)
+	if closeIdx < 0 {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("malformed tuple type %q", typeStr)
+	}
+	inner := typeStr[1:closeIdx]
+	arraySuffix := typeStr[closeIdx+1:]
+
+	components := []abi.ArgumentMarshaling{}
+	for i, part := range splitTopLevelArgs(inner) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		component, err := parseArgumentMarshaling(part, fmt.Sprintf("arg%d", i))
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+		components = append(components, component)
+	}
+
+	return abi.ArgumentMarshaling{
+		Name:       name,
+		Type:       "tuple" + arraySuffix,
+		Components: components,
+	}, nil
+}
+
+// matchingParen returns the index of the ')' matching the '(' at the start
+// of s, or -1 if s is malformed.
+func matchingParen(s string) int {
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 // createRawDataField creates a field for raw transaction data
 func (d *TransactionDecoder) createRawDataField(data []byte) SignablePayloadField {
 	return SignablePayloadField{
@@ -304,6 +1047,21 @@ func (d *TransactionDecoder) createRawDataField(data []byte) SignablePayloadFiel
 	}
 }
 
+// signerFor picks the signer used to recover a transaction's sender. It
+// prefers the decoder's configured chain ID, then the chain ID embedded in
+// typed transactions, and only falls back to a signer that tolerates
+// unprotected legacy transactions when neither is available - in which case
+// the returned bool is false so callers can warn that the sender is unverified.
+func (d *TransactionDecoder) signerFor(tx *types.Transaction) (types.Signer, bool) {
+	if d.chainID != nil {
+		return types.LatestSignerForChainID(d.chainID), true
+	}
+	if chainID := tx.ChainId(); chainID != nil && chainID.Sign() > 0 {
+		return types.LatestSignerForChainID(chainID), true
+	}
+	return types.HomesteadSigner{}, false
+}
+
 // getAddressName returns a friendly name for an address if known
 func (d *TransactionDecoder) getAddressName(addr common.Address) string {
 	if _, exists := d.abiMap[addr]; exists {
@@ -313,6 +1071,320 @@ func (d *TransactionDecoder) getAddressName(addr common.Address) string {
 	return ""
 }
 
+// normalizeJSONNumbers recursively converts json.Number leaves to their
+// exact decimal string form, leaving everything else untouched. apitypes'
+// hashing helpers accept strings (and preserve precision) but reject
+// json.Number, so message trees decoded with UseNumber must be normalized
+// before hashing.
+func normalizeJSONNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		return v.String()
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			normalized[key] = normalizeJSONNumbers(val)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, val := range v {
+			normalized[i] = normalizeJSONNumbers(val)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
+
+// DecodeTypedData decodes an EIP-712 typed-data JSON document (`types`,
+// `primaryType`, `domain`, `message`) into a VisualSign payload, surfacing
+// the domain, a nested breakdown of the message per its primaryType schema,
+// and the exact digest an eth_signTypedData_v4 call would sign.
+func (d *TransactionDecoder) DecodeTypedData(jsonBytes []byte) (*SignablePayload, error) {
+	var typedData apitypes.TypedData
+	// UseNumber keeps large integer message fields (uint256 amounts, token
+	// IDs) as json.Number instead of float64, which would silently round
+	// them - unacceptable for a payload the user is about to sign. The display
+	// path below reads this tree directly; the hashes use a normalized copy.
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&typedData); err != nil {
+		return nil, fmt.Errorf("failed to parse EIP-712 typed data: %w", err)
+	}
+
+	// apitypes' hashing helpers (HashStruct/TypedDataAndHash) reject
+	// json.Number outright, and re-decoding without UseNumber would hash a
+	// float64-rounded copy - silently signing a different integer than the
+	// one displayed for any value above 2^53. Instead, hash against the same
+	// decoded tree with json.Number leaves normalized to their exact decimal
+	// string form, which apitypes accepts without losing precision.
+	hashTypedData := typedData
+	hashTypedData.Message, _ = normalizeJSONNumbers(typedData.Message).(map[string]interface{})
+
+	fields := []SignablePayloadField{}
+
+	fields = append(fields, SignablePayloadField{
+		Type:         "text_v2",
+		Label:        "Network",
+		FallbackText: "Ethereum",
+		TextV2: &SignablePayloadFieldTextV2{
+			Text: "Ethereum",
+		},
+	})
+
+	if typedData.Domain.Name != "" {
+		fields = append(fields, SignablePayloadField{
+			Type:         "text_v2",
+			Label:        "App",
+			FallbackText: typedData.Domain.Name,
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: typedData.Domain.Name,
+			},
+		})
+	}
+
+	if typedData.Domain.Version != "" {
+		fields = append(fields, SignablePayloadField{
+			Type:         "text_v2",
+			Label:        "Version",
+			FallbackText: typedData.Domain.Version,
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: typedData.Domain.Version,
+			},
+		})
+	}
+
+	if typedData.Domain.ChainId != nil {
+		chainID := (*big.Int)(typedData.Domain.ChainId).String()
+		fields = append(fields, SignablePayloadField{
+			Type:         "text_v2",
+			Label:        "Chain ID",
+			FallbackText: chainID,
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: chainID,
+			},
+		})
+	}
+
+	if typedData.Domain.VerifyingContract != "" {
+		verifyingContract := common.HexToAddress(typedData.Domain.VerifyingContract)
+		fields = append(fields, SignablePayloadField{
+			Type:         "address_v2",
+			Label:        "Verifying Contract",
+			FallbackText: verifyingContract.Hex(),
+			AddressV2: &SignablePayloadFieldAddressV2{
+				Address:    verifyingContract.Hex(),
+				Name:       d.getAddressName(verifyingContract),
+				AssetLabel: "ETH",
+			},
+		})
+	}
+
+	messageField, err := d.typedDataStructField("Message", typedData.PrimaryType, typedData.Message, typedData.Types)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render EIP-712 message: %w", err)
+	}
+	fields = append(fields, messageField.SignablePayloadField)
+
+	domainSeparator, err := hashTypedData.HashStruct("EIP712Domain", hashTypedData.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash EIP-712 domain: %w", err)
+	}
+	fields = append(fields, SignablePayloadField{
+		Type:         "text_v2",
+		Label:        "Domain Separator",
+		FallbackText: fmt.Sprintf("0x%x", []byte(domainSeparator)),
+		TextV2: &SignablePayloadFieldTextV2{
+			Text: fmt.Sprintf("0x%x", []byte(domainSeparator)),
+		},
+	})
+
+	structHash, err := hashTypedData.HashStruct(hashTypedData.PrimaryType, hashTypedData.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash EIP-712 message: %w", err)
+	}
+	fields = append(fields, SignablePayloadField{
+		Type:         "text_v2",
+		Label:        "Struct Hash",
+		FallbackText: fmt.Sprintf("0x%x", []byte(structHash)),
+		TextV2: &SignablePayloadFieldTextV2{
+			Text: fmt.Sprintf("0x%x", []byte(structHash)),
+		},
+	})
+
+	digest, _, err := apitypes.TypedDataAndHash(hashTypedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute EIP-712 signing digest: %w", err)
+	}
+	fields = append(fields, SignablePayloadField{
+		Type:         "text_v2",
+		Label:        "Signing Digest",
+		FallbackText: fmt.Sprintf("0x%x", digest),
+		TextV2: &SignablePayloadFieldTextV2{
+			Text: fmt.Sprintf("0x%x", digest),
+		},
+	})
+
+	return &SignablePayload{
+		Version:     0,
+		Title:       "Signed Message",
+		Fields:      fields,
+		PayloadType: "typed_data",
+	}, nil
+}
+
+// typedDataStructField renders an EIP-712 struct value as a preview_layout
+// with one expanded sub-field per schema member, recursing into referenced
+// struct types and arrays.
+func (d *TransactionDecoder) typedDataStructField(label, typeName string, value map[string]interface{}, types apitypes.Types) (*AnnotatedPayloadField, error) {
+	schema, ok := types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown EIP-712 type %q", typeName)
+	}
+
+	memberFields := make([]*AnnotatedPayloadField, 0, len(schema))
+	for _, member := range schema {
+		field, err := d.typedDataValueField(member.Name, member.Type, value[member.Name], types)
+		if err != nil {
+			return nil, err
+		}
+		memberFields = append(memberFields, field)
+	}
+
+	summary := fmt.Sprintf("%s (%d fields)", typeName, len(memberFields))
+	return &AnnotatedPayloadField{
+		SignablePayloadField: SignablePayloadField{
+			Type:         "preview_layout",
+			Label:        label,
+			FallbackText: summary,
+			PreviewLayout: &SignablePayloadFieldPreviewLayout{
+				Title: SignablePayloadFieldTextV2{
+					Text: label,
+				},
+				Condensed: SignablePayloadFieldListLayout{
+					Fields: []*AnnotatedPayloadField{
+						{
+							SignablePayloadField: SignablePayloadField{
+								Type:         "text_v2",
+								Label:        label,
+								FallbackText: summary,
+								TextV2: &SignablePayloadFieldTextV2{
+									Text: summary,
+								},
+							},
+						},
+					},
+				},
+				Expanded: SignablePayloadFieldListLayout{
+					Fields: memberFields,
+				},
+			},
+		},
+	}, nil
+}
+
+// typedDataValueField renders a single EIP-712 schema member: arrays recurse
+// per element, referenced struct types recurse via typedDataStructField, and
+// everything else is formatted as a leaf value.
+func (d *TransactionDecoder) typedDataValueField(label, typeName string, value interface{}, types apitypes.Types) (*AnnotatedPayloadField, error) {
+	if open := strings.LastIndex(typeName, "["); open > 0 && strings.HasSuffix(typeName, "]") {
+		elemType := typeName[:open]
+		items, _ := value.([]interface{})
+		elemFields := make([]*AnnotatedPayloadField, 0, len(items))
+		for i, item := range items {
+			elemLabel := fmt.Sprintf("%s[%d]", label, i)
+			field, err := d.typedDataValueField(elemLabel, elemType, item, types)
+			if err != nil {
+				return nil, err
+			}
+			elemFields = append(elemFields, field)
+		}
+
+		summary := fmt.Sprintf("%d items", len(items))
+		return &AnnotatedPayloadField{
+			SignablePayloadField: SignablePayloadField{
+				Type:         "preview_layout",
+				Label:        label,
+				FallbackText: summary,
+				PreviewLayout: &SignablePayloadFieldPreviewLayout{
+					Title: SignablePayloadFieldTextV2{
+						Text: label,
+					},
+					Condensed: SignablePayloadFieldListLayout{
+						Fields: []*AnnotatedPayloadField{
+							{
+								SignablePayloadField: SignablePayloadField{
+									Type:         "text_v2",
+									Label:        label,
+									FallbackText: summary,
+									TextV2: &SignablePayloadFieldTextV2{
+										Text: summary,
+									},
+								},
+							},
+						},
+					},
+					Expanded: SignablePayloadFieldListLayout{
+						Fields: elemFields,
+					},
+				},
+			},
+		}, nil
+	}
+
+	if _, isStruct := types[typeName]; isStruct {
+		nestedValue, _ := value.(map[string]interface{})
+		return d.typedDataStructField(label, typeName, nestedValue, types)
+	}
+
+	text := d.formatTypedDataValue(value, typeName)
+	return &AnnotatedPayloadField{
+		SignablePayloadField: SignablePayloadField{
+			Type:         "text_v2",
+			Label:        label,
+			FallbackText: text,
+			TextV2: &SignablePayloadFieldTextV2{
+				Text: text,
+			},
+		},
+	}, nil
+}
+
+// formatTypedDataValue formats a JSON-decoded EIP-712 leaf value per its
+// solidity type name, mirroring formatABIValue's address/uint/bytes
+// conventions for the types EIP-712 messages actually carry.
+func (d *TransactionDecoder) formatTypedDataValue(value interface{}, typeName string) string {
+	switch {
+	case typeName == "address":
+		if s, ok := value.(string); ok {
+			return common.HexToAddress(s).Hex()
+		}
+	case strings.HasPrefix(typeName, "uint"), strings.HasPrefix(typeName, "int"):
+		switch v := value.(type) {
+		case json.Number:
+			if bigInt, ok := new(big.Int).SetString(v.String(), 10); ok {
+				return bigInt.String()
+			}
+			return v.String()
+		case string:
+			if bigInt, ok := new(big.Int).SetString(v, 0); ok {
+				return bigInt.String()
+			}
+			return v
+		}
+	case typeName == "bool":
+		if b, ok := value.(bool); ok {
+			return fmt.Sprintf("%t", b)
+		}
+	case typeName == "string", strings.HasPrefix(typeName, "bytes"):
+		if s, ok := value.(string); ok {
+			return s
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
 // DecodeTransactionJSON is a convenience function that returns JSON
 func (d *TransactionDecoder) DecodeTransactionJSON(rawTxHex string) (string, error) {
 	payload, err := d.DecodeRawTransaction(rawTxHex)